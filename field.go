@@ -0,0 +1,139 @@
+package main
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+)
+
+// Element is an opaque value belonging to a particular Field; only that
+// Field's own methods know how to interpret or operate on it. Concretely
+// it is always either a *big.Int (PrimeField and its curve-scalar variants)
+// or a *big.Rat (IntegerField).
+type Element interface{}
+
+// Field abstracts the arithmetic Shamir reconstruction runs over, so the
+// same Point/parseJSON/lagrangeInterpolation code works whether shares are
+// plain integers, elements of a prime field, or scalars of an elliptic
+// curve's group, just by swapping the Field implementation.
+type Field interface {
+	Add(a, b Element) Element
+	Sub(a, b Element) Element
+	Mul(a, b Element) Element
+	Inv(a Element) (Element, bool)
+	FromBytes(b []byte) Element
+	Zero() Element
+	One() Element
+	Equal(a, b Element) bool
+}
+
+// IntegerField reproduces this module's original big.Rat-based exact
+// arithmetic: reconstruction happens over the rationals, and the result is
+// only meaningful if it comes out to an exact integer.
+type IntegerField struct{}
+
+func (IntegerField) Add(a, b Element) Element {
+	return new(big.Rat).Add(a.(*big.Rat), b.(*big.Rat))
+}
+
+func (IntegerField) Sub(a, b Element) Element {
+	return new(big.Rat).Sub(a.(*big.Rat), b.(*big.Rat))
+}
+
+func (IntegerField) Mul(a, b Element) Element {
+	return new(big.Rat).Mul(a.(*big.Rat), b.(*big.Rat))
+}
+
+func (IntegerField) Inv(a Element) (Element, bool) {
+	r := a.(*big.Rat)
+	if r.Sign() == 0 {
+		return nil, false
+	}
+	return new(big.Rat).Inv(r), true
+}
+
+func (IntegerField) FromBytes(b []byte) Element {
+	return new(big.Rat).SetInt(new(big.Int).SetBytes(b))
+}
+
+func (IntegerField) Zero() Element {
+	return big.NewRat(0, 1)
+}
+
+func (IntegerField) One() Element {
+	return big.NewRat(1, 1)
+}
+
+func (IntegerField) Equal(a, b Element) bool {
+	return a.(*big.Rat).Cmp(b.(*big.Rat)) == 0
+}
+
+// PrimeField performs arithmetic in Z/pZ using big.Int mod-p operations and
+// modular inverses (via ModInverse), so Shamir shares produced over a
+// finite field can be reconstructed exactly rather than only as integers.
+type PrimeField struct {
+	P *big.Int
+}
+
+func (f PrimeField) Add(a, b Element) Element {
+	return new(big.Int).Mod(new(big.Int).Add(a.(*big.Int), b.(*big.Int)), f.P)
+}
+
+func (f PrimeField) Sub(a, b Element) Element {
+	return new(big.Int).Mod(new(big.Int).Sub(a.(*big.Int), b.(*big.Int)), f.P)
+}
+
+func (f PrimeField) Mul(a, b Element) Element {
+	return new(big.Int).Mod(new(big.Int).Mul(a.(*big.Int), b.(*big.Int)), f.P)
+}
+
+func (f PrimeField) Inv(a Element) (Element, bool) {
+	inv := new(big.Int).ModInverse(a.(*big.Int), f.P)
+	if inv == nil {
+		return nil, false
+	}
+	return inv, true
+}
+
+func (f PrimeField) FromBytes(b []byte) Element {
+	return new(big.Int).Mod(new(big.Int).SetBytes(b), f.P)
+}
+
+func (f PrimeField) Zero() Element {
+	return big.NewInt(0)
+}
+
+func (f PrimeField) One() Element {
+	return big.NewInt(1)
+}
+
+func (f PrimeField) Equal(a, b Element) bool {
+	return a.(*big.Int).Cmp(b.(*big.Int)) == 0
+}
+
+// curveOrders maps a supported elliptic curve's name to its scalar field
+// (group) order, not its underlying coordinate-field prime: Shamir shares
+// of an EC private key live in Z/nZ where n is this order.
+var curveOrders = map[string]*big.Int{
+	"p256":    elliptic.P256().Params().N,
+	"ed25519": mustParseDecimal("7237005577332262213973186563042994240857116359379907606001950938285454250989"),
+}
+
+// CurveField returns a Field performing scalar arithmetic for the named
+// elliptic curve, so shares produced by ecosystem tools that split EC
+// private keys can be reconstructed here.
+func CurveField(name string) (Field, error) {
+	order, ok := curveOrders[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported curve: %s", name)
+	}
+	return PrimeField{P: order}, nil
+}
+
+func mustParseDecimal(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic(fmt.Sprintf("field: invalid constant %q", s))
+	}
+	return n
+}
@@ -1,27 +1,78 @@
 package main
 
 import (
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"math/big"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
+
+	"github.com/NEMYSESx/harisha-assignment/sharing"
 )
 
+// Point is a single Shamir share, (x, f(x)). Its coordinates are Field
+// elements rather than concrete numbers so the same Point type works across
+// IntegerField, PrimeField, and curve-scalar backends; code that works with
+// a specific backend (the dealer, Feldman VSS, Berlekamp-Welch) asserts the
+// concrete *big.Int representation those backends use.
 type Point struct {
-	X *big.Int
-	Y *big.Int
+	X Element
+	Y Element
+}
+
+// parseField builds the Field a JSON payload's points live in. The
+// "field" object selects either an explicit-modulus prime field or a named
+// curve's scalar field; the older bare "prime" string is still honored for
+// backward compatibility with shares generated before that schema existed.
+// With neither present, shares are assumed to be plain integers and
+// reconstructed exactly via IntegerField.
+func parseField(rawData map[string]interface{}) (Field, error) {
+	if fieldData, ok := rawData["field"].(map[string]interface{}); ok {
+		fieldType, _ := fieldData["type"].(string)
+		switch fieldType {
+		case "prime":
+			modulusStr, ok := fieldData["modulus"].(string)
+			if !ok {
+				return nil, fmt.Errorf(`prime field requires a "modulus" string`)
+			}
+			modulus, ok := new(big.Int).SetString(modulusStr, 10)
+			if !ok {
+				return nil, fmt.Errorf("invalid modulus: %s", modulusStr)
+			}
+			return PrimeField{P: modulus}, nil
+		case "curve":
+			name, ok := fieldData["name"].(string)
+			if !ok {
+				return nil, fmt.Errorf(`curve field requires a "name" string`)
+			}
+			return CurveField(name)
+		default:
+			return nil, fmt.Errorf("unsupported field type: %s", fieldType)
+		}
+	}
+
+	if primeStr, ok := rawData["prime"].(string); ok {
+		modulus, ok := new(big.Int).SetString(primeStr, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid prime: %s", primeStr)
+		}
+		return PrimeField{P: modulus}, nil
+	}
+
+	return IntegerField{}, nil
 }
 
-func parseJSON(jsonData string) ([]Point, int, error) {
+func parseJSON(jsonData string) ([]Point, int, Field, error) {
 	// First parse into a generic map to handle the mixed structure
 	var rawData map[string]interface{}
 	err := json.Unmarshal([]byte(jsonData), &rawData)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to parse JSON: %v", err)
+		return nil, 0, nil, fmt.Errorf("failed to parse JSON: %v", err)
 	}
 
 	// Extract keys - handle both object and direct access
@@ -30,15 +81,28 @@ func parseJSON(jsonData string) ([]Point, int, error) {
 		if kVal, ok := keysData["k"].(float64); ok {
 			k = int(kVal)
 		} else {
-			return nil, 0, fmt.Errorf("k value not found or invalid type")
+			return nil, 0, nil, fmt.Errorf("k value not found or invalid type")
 		}
 	} else {
-		return nil, 0, fmt.Errorf("keys field not found or invalid")
+		return nil, 0, nil, fmt.Errorf("keys field not found or invalid")
+	}
+
+	field, err := parseField(rawData)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	// Collect the raw (x, y) pairs first and only build Field elements once
+	// they're sorted and deduplicated, since comparing Elements directly
+	// isn't meaningful across every backend.
+	type rawPoint struct {
+		x int
+		y *big.Int
 	}
 
-	var points []Point
+	var rawPoints []rawPoint
 	for key, value := range rawData {
-		if key == "keys" {
+		if key == "keys" || key == "prime" || key == "field" || key == "commitments" {
 			continue
 		}
 
@@ -61,128 +125,110 @@ func parseJSON(jsonData string) ([]Point, int, error) {
 
 		baseStr, ok := rootMap["base"].(string)
 		if !ok {
-			return nil, 0, fmt.Errorf("invalid base for key %s", key)
+			return nil, 0, nil, fmt.Errorf("invalid base for key %s", key)
 		}
 
 		valueStr, ok := rootMap["value"].(string)
 		if !ok {
-			return nil, 0, fmt.Errorf("invalid value for key %s", key)
+			return nil, 0, nil, fmt.Errorf("invalid value for key %s", key)
 		}
 
 		// Validate base
 		base, err := strconv.Atoi(baseStr)
 		if err != nil || base < 2 || base > 36 {
-			return nil, 0, fmt.Errorf("invalid base for key %s: %s (must be 2-36)", key, baseStr)
+			return nil, 0, nil, fmt.Errorf("invalid base for key %s: %s (must be 2-36)", key, baseStr)
 		}
 
 		// Validate and parse value
 		if valueStr == "" {
-			return nil, 0, fmt.Errorf("empty value for key %s", key)
+			return nil, 0, nil, fmt.Errorf("empty value for key %s", key)
 		}
 
 		y := big.NewInt(0)
 		y, ok = y.SetString(valueStr, base)
 		if !ok {
-			return nil, 0, fmt.Errorf("invalid value for key %s: %s in base %d", key, valueStr, base)
+			return nil, 0, nil, fmt.Errorf("invalid value for key %s: %s in base %d", key, valueStr, base)
 		}
 
 		// Check for negative values (shouldn't happen in valid secret sharing)
 		if y.Sign() < 0 {
-			return nil, 0, fmt.Errorf("negative value for key %s: %s", key, y.String())
+			return nil, 0, nil, fmt.Errorf("negative value for key %s: %s", key, y.String())
 		}
 
-		points = append(points, Point{
-			X: big.NewInt(int64(x)),
-			Y: y,
-		})
+		rawPoints = append(rawPoints, rawPoint{x: x, y: y})
 	}
 
 	// Sort points by X coordinate to ensure consistent ordering
-	sort.Slice(points, func(i, j int) bool {
-		return points[i].X.Cmp(points[j].X) < 0
+	sort.Slice(rawPoints, func(i, j int) bool {
+		return rawPoints[i].x < rawPoints[j].x
 	})
 
 	// Check for duplicate X values
-	for i := 1; i < len(points); i++ {
-		if points[i].X.Cmp(points[i-1].X) == 0 {
-			return nil, 0, fmt.Errorf("duplicate x coordinate: %s", points[i].X.String())
+	for i := 1; i < len(rawPoints); i++ {
+		if rawPoints[i].x == rawPoints[i-1].x {
+			return nil, 0, nil, fmt.Errorf("duplicate x coordinate: %d", rawPoints[i].x)
 		}
 	}
 
-	return points, k, nil
+	points := make([]Point, len(rawPoints))
+	for i, rp := range rawPoints {
+		points[i] = Point{
+			X: field.FromBytes(big.NewInt(int64(rp.x)).Bytes()),
+			Y: field.FromBytes(rp.y.Bytes()),
+		}
+	}
+
+	return points, k, field, nil
 }
 
-func lagrangeInterpolation(points []Point, k int) *big.Int {
+// lagrangeInterpolation reconstructs f(0) from k of the given points via
+// Lagrange interpolation over the supplied field. IntegerField reproduces
+// this module's original exact-rational behavior; PrimeField and the
+// curve-scalar backends interpolate in Z/pZ using modular inverses instead.
+func lagrangeInterpolation(field Field, points []Point, k int) Element {
 	if len(points) < k {
 		return nil
 	}
 
 	selectedPoints := points[:k]
-
-	// Use rational arithmetic for exact computation
-	result := big.NewRat(0, 1)
+	result := field.Zero()
 
 	for i := 0; i < len(selectedPoints); i++ {
 		// Calculate Lagrange basis polynomial L_i(0)
-		numerator := big.NewRat(1, 1)
-		denominator := big.NewRat(1, 1)
+		numerator := field.One()
+		denominator := field.One()
 
 		for j := 0; j < len(selectedPoints); j++ {
-			if i != j {
-				// For L_i(0), we want (0 - x_j) / (x_i - x_j)
-
-				// Numerator: multiply by (0 - x_j) = -x_j
-				xj := big.NewRat(0, 1)
-				xj.SetInt(selectedPoints[j].X)
-				xj.Neg(xj)
-				numerator.Mul(numerator, xj)
-
-				// Denominator: multiply by (x_i - x_j)
-				xi := big.NewRat(0, 1)
-				xi.SetInt(selectedPoints[i].X)
-				xj = big.NewRat(0, 1)
-				xj.SetInt(selectedPoints[j].X)
-				diff := big.NewRat(0, 1)
-				diff.Sub(xi, xj)
-
-				// Check for zero difference (duplicate points)
-				if diff.Sign() == 0 {
-					return nil
-				}
-
-				denominator.Mul(denominator, diff)
+			if i == j {
+				continue
 			}
+
+			// For L_i(0), we want (0 - x_j) / (x_i - x_j)
+			numerator = field.Mul(numerator, field.Sub(field.Zero(), selectedPoints[j].X))
+
+			diff := field.Sub(selectedPoints[i].X, selectedPoints[j].X)
+			if field.Equal(diff, field.Zero()) {
+				// Duplicate points
+				return nil
+			}
+			denominator = field.Mul(denominator, diff)
 		}
 
-		// Check for zero denominator
-		if denominator.Sign() == 0 {
+		denomInv, ok := field.Inv(denominator)
+		if !ok {
 			return nil
 		}
 
-		// Calculate the Lagrange basis value L_i(0)
-		basisValue := big.NewRat(0, 1)
-		basisValue.Quo(numerator, denominator)
-
-		// Multiply by y_i and add to result
-		yi := big.NewRat(0, 1)
-		yi.SetInt(selectedPoints[i].Y)
-		term := big.NewRat(0, 1)
-		term.Mul(yi, basisValue)
-		result.Add(result, term)
-	}
-
-	// Convert rational result back to integer
-	// The result should be an exact integer for valid Shamir's Secret Sharing
-	if !result.IsInt() {
-		// This shouldn't happen with valid secret sharing, but handle it gracefully
-		return nil
+		basisValue := field.Mul(numerator, denomInv)
+		term := field.Mul(selectedPoints[i].Y, basisValue)
+		result = field.Add(result, term)
 	}
 
-	return result.Num()
+	return result
 }
 
 func solveSecretSharing(jsonData string) (*big.Int, error) {
-	points, k, err := parseJSON(jsonData)
+	points, k, field, err := parseJSON(jsonData)
 	if err != nil {
 		return nil, err
 	}
@@ -195,12 +241,460 @@ func solveSecretSharing(jsonData string) (*big.Int, error) {
 		return nil, fmt.Errorf("insufficient points: need %d, got %d", k, len(points))
 	}
 
-	secret := lagrangeInterpolation(points, k)
-	if secret == nil {
+	result := lagrangeInterpolation(field, points, k)
+	if result == nil {
 		return nil, fmt.Errorf("failed to interpolate polynomial")
 	}
 
-	return secret, nil
+	switch v := result.(type) {
+	case *big.Rat:
+		// The result should be an exact integer for valid Shamir's Secret Sharing
+		if !v.IsInt() {
+			return nil, fmt.Errorf("failed to interpolate polynomial")
+		}
+		return v.Num(), nil
+	case *big.Int:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unsupported field element type %T", result)
+	}
+}
+
+// LagrangeInterpolateMod reconstructs f(0) from k of the given points using
+// Lagrange interpolation in Z/pZ, replacing big.Rat division with modular
+// inverses (via ModInverse) so the result is exact even when the rational
+// reconstruction would require a non-integer coefficient. Points must carry
+// *big.Int coordinates, as produced by Create/CreateVerifiable; points
+// parsed from plain (no "prime"/"field") JSON use *big.Rat instead and
+// are rejected rather than panicking.
+func LagrangeInterpolateMod(points []Point, k int, prime *big.Int) *big.Int {
+	if len(points) < k {
+		return nil
+	}
+
+	selectedPoints := points[:k]
+	result := big.NewInt(0)
+
+	for i := 0; i < len(selectedPoints); i++ {
+		numerator := big.NewInt(1)
+		denominator := big.NewInt(1)
+
+		for j := 0; j < len(selectedPoints); j++ {
+			if i == j {
+				continue
+			}
+
+			xi, ok := selectedPoints[i].X.(*big.Int)
+			if !ok {
+				return nil
+			}
+			xj, ok := selectedPoints[j].X.(*big.Int)
+			if !ok {
+				return nil
+			}
+
+			numerator.Mul(numerator, new(big.Int).Neg(xj))
+			numerator.Mod(numerator, prime)
+
+			diff := new(big.Int).Sub(xi, xj)
+			diff.Mod(diff, prime)
+			if diff.Sign() == 0 {
+				return nil
+			}
+			denominator.Mul(denominator, diff)
+			denominator.Mod(denominator, prime)
+		}
+
+		denomInv := new(big.Int).ModInverse(denominator, prime)
+		if denomInv == nil {
+			return nil
+		}
+
+		basisValue := new(big.Int).Mul(numerator, denomInv)
+		basisValue.Mod(basisValue, prime)
+
+		yi, ok := selectedPoints[i].Y.(*big.Int)
+		if !ok {
+			return nil
+		}
+		term := new(big.Int).Mul(yi, basisValue)
+		term.Mod(term, prime)
+
+		result.Add(result, term)
+		result.Mod(result, prime)
+	}
+
+	return result
+}
+
+// generateCoefficients picks the minimum-1 random coefficients (mod prime)
+// above the constant term, i.e. the polynomial f(x) = secret + a1*x + ... +
+// a_{minimum-1}*x^{minimum-1} used by the dealer to split a secret.
+func generateCoefficients(minimum int, prime, secret *big.Int) ([]*big.Int, error) {
+	coeffs := make([]*big.Int, minimum)
+	coeffs[0] = new(big.Int).Set(secret)
+	for i := 1; i < minimum; i++ {
+		c, err := rand.Int(rand.Reader, prime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate coefficient: %v", err)
+		}
+		coeffs[i] = c
+	}
+	return coeffs, nil
+}
+
+// evalPolynomialMod evaluates a polynomial (given by its coefficients, low
+// degree first) at x, modulo prime.
+func evalPolynomialMod(coeffs []*big.Int, x, prime *big.Int) *big.Int {
+	result := big.NewInt(0)
+	xPow := big.NewInt(1)
+	term := new(big.Int)
+	for _, c := range coeffs {
+		term.Mul(c, xPow)
+		result.Add(result, term)
+		result.Mod(result, prime)
+		xPow.Mul(xPow, x)
+		xPow.Mod(xPow, prime)
+	}
+	return result
+}
+
+// evalPoints evaluates the polynomial defined by coeffs at x = 1..shares,
+// producing one Point per recipient.
+func evalPoints(coeffs []*big.Int, shares int, prime *big.Int) []Point {
+	points := make([]Point, shares)
+	for x := 1; x <= shares; x++ {
+		xBig := big.NewInt(int64(x))
+		points[x-1] = Point{X: xBig, Y: evalPolynomialMod(coeffs, xBig, prime)}
+	}
+	return points
+}
+
+// Create acts as a trusted dealer: it picks minimum-1 random coefficients
+// modulo prime, forms f(x) = secret + a1*x + ... + a_{minimum-1}*x^{minimum-1}
+// mod prime, and evaluates it at x = 1..shares to produce Shamir shares.
+func Create(minimum, shares int, prime *big.Int, secret *big.Int) ([]Point, error) {
+	if minimum < 1 {
+		return nil, fmt.Errorf("minimum must be at least 1")
+	}
+	if shares < minimum {
+		return nil, fmt.Errorf("shares (%d) must be >= minimum (%d)", shares, minimum)
+	}
+	if prime == nil || prime.Sign() <= 0 {
+		return nil, fmt.Errorf("prime must be a positive modulus")
+	}
+	if secret == nil || secret.Sign() < 0 || secret.Cmp(prime) >= 0 {
+		return nil, fmt.Errorf("secret must be in range [0, prime)")
+	}
+
+	coeffs, err := generateCoefficients(minimum, prime, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return evalPoints(coeffs, shares, prime), nil
+}
+
+// CreateVerifiable is a Feldman VSS dealer: it splits secret the same way
+// Create does, but over the subgroup of order q generated by g modulo p, and
+// additionally publishes commitments C_j = g^{a_j} mod p for every
+// polynomial coefficient a_j. Recipients can then check their share against
+// the commitments via VerifyShare without trusting the dealer.
+func CreateVerifiable(minimum, shares int, p, g, q, secret *big.Int) ([]Point, []*big.Int, error) {
+	if minimum < 1 {
+		return nil, nil, fmt.Errorf("minimum must be at least 1")
+	}
+	if shares < minimum {
+		return nil, nil, fmt.Errorf("shares (%d) must be >= minimum (%d)", shares, minimum)
+	}
+	if p == nil || p.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("prime must be a positive modulus")
+	}
+	if g == nil || g.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("generator must be positive")
+	}
+	if q == nil || q.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("subgroup order must be a positive modulus")
+	}
+	if secret == nil || secret.Sign() < 0 || secret.Cmp(q) >= 0 {
+		return nil, nil, fmt.Errorf("secret must be in range [0, subgroup order)")
+	}
+
+	coeffs, err := generateCoefficients(minimum, q, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	points := evalPoints(coeffs, shares, q)
+
+	commitments := make([]*big.Int, minimum)
+	for j, a := range coeffs {
+		commitments[j] = new(big.Int).Exp(g, a, p)
+	}
+
+	return points, commitments, nil
+}
+
+// VerifyShare checks a single share against the dealer's published Feldman
+// commitments by confirming g^{y_i} ≡ ∏ C_j^{x_i^j} (mod p). It lets a
+// recipient detect a cheating dealer without any trusted channel. point
+// must carry *big.Int coordinates, as produced by CreateVerifiable; any
+// other representation (e.g. a plain IntegerField point) fails verification
+// rather than panicking.
+func VerifyShare(point Point, commitments []*big.Int, p, g *big.Int) bool {
+	if len(commitments) == 0 {
+		return false
+	}
+
+	y, ok := point.Y.(*big.Int)
+	if !ok {
+		return false
+	}
+	x, ok := point.X.(*big.Int)
+	if !ok {
+		return false
+	}
+
+	lhs := new(big.Int).Exp(g, y, p)
+
+	rhs := big.NewInt(1)
+	xPow := big.NewInt(1)
+	for _, c := range commitments {
+		rhs.Mul(rhs, new(big.Int).Exp(c, xPow, p))
+		rhs.Mod(rhs, p)
+		xPow.Mul(xPow, x)
+	}
+
+	return lhs.Cmp(rhs) == 0
+}
+
+// RobustReconstruct recovers the secret from points that may include
+// corrupted shares, using Berlekamp-Welch decoding over Z/pZ. With n shares
+// and up to e = (n-k)/2 errors, it solves for a degree-e monic error locator
+// E(x) and a degree-<(k+e) polynomial Q(x) satisfying Q(x_i) = y_i*E(x_i)
+// for every point; P(x) = Q(x)/E(x) is then the original degree-(k-1)
+// polynomial, and the roots of E identify the corrupted shares. It returns
+// P(0) as the secret along with the indices (into points) of the bad
+// shares. When fewer than e shares are corrupted the system for e has no
+// unique solution, so it is solved again with e-1, down to e = 0, until one
+// has. Points must carry *big.Int coordinates, as produced by Create;
+// any other representation (e.g. a plain IntegerField point) is rejected
+// with an error rather than panicking.
+func RobustReconstruct(points []Point, k int, prime *big.Int) (*big.Int, []int, error) {
+	if k < 1 {
+		return nil, nil, fmt.Errorf("invalid k value: %d (must be positive)", k)
+	}
+
+	n := len(points)
+	if n < k {
+		return nil, nil, fmt.Errorf("insufficient points: need at least %d, got %d", k, n)
+	}
+
+	// e = floor((n-k)/2) is the most errors this many points can ever
+	// correct, so n >= k+2e holds by construction; there is no n/k/e
+	// combination left to reject here.
+	e := (n - k) / 2
+
+	if e == 0 {
+		secret := LagrangeInterpolateMod(points, k, prime)
+		if secret == nil {
+			return nil, nil, fmt.Errorf("failed to interpolate polynomial")
+		}
+		return secret, nil, nil
+	}
+
+retry:
+	qDeg := k + e
+	unknowns := qDeg + e
+
+	// Build the linear system: for each point i,
+	//   sum_j q_j*x_i^j  -  sum_j e_j*y_i*x_i^j  =  y_i*x_i^e
+	// (E is monic, so its x_i^e term is known and moved to the RHS).
+	rows := make([][]*big.Int, n)
+	for i, pt := range points {
+		x, ok := pt.X.(*big.Int)
+		if !ok {
+			return nil, nil, fmt.Errorf("point %d: expected *big.Int coordinates", i)
+		}
+		y, ok := pt.Y.(*big.Int)
+		if !ok {
+			return nil, nil, fmt.Errorf("point %d: expected *big.Int coordinates", i)
+		}
+		row := make([]*big.Int, unknowns+1)
+
+		xPow := big.NewInt(1)
+		for j := 0; j < qDeg; j++ {
+			row[j] = new(big.Int).Mod(xPow, prime)
+			xPow = new(big.Int).Mul(xPow, x)
+		}
+
+		xPow = big.NewInt(1)
+		for j := 0; j < e; j++ {
+			term := new(big.Int).Mul(y, xPow)
+			term.Neg(term)
+			row[qDeg+j] = new(big.Int).Mod(term, prime)
+			xPow = new(big.Int).Mul(xPow, x)
+		}
+
+		rhs := new(big.Int).Exp(x, big.NewInt(int64(e)), prime)
+		rhs.Mul(rhs, y)
+		row[unknowns] = new(big.Int).Mod(rhs, prime)
+
+		rows[i] = row
+	}
+
+	solution, err := solveLinearSystemMod(rows, unknowns, prime)
+	if err != nil {
+		// A system with a smaller e is solvable only if this one was, so an
+		// inconsistent system still ends in an error once e reaches 0.
+		if e > 0 {
+			e--
+			goto retry
+		}
+		return nil, nil, err
+	}
+
+	qCoeffs := solution[:qDeg]
+	eCoeffs := append(append([]*big.Int{}, solution[qDeg:]...), big.NewInt(1))
+
+	var badIndices []int
+	for i, pt := range points {
+		x, ok := pt.X.(*big.Int)
+		if !ok {
+			return nil, nil, fmt.Errorf("point %d: expected *big.Int coordinates", i)
+		}
+		if evalPolynomialMod(eCoeffs, x, prime).Sign() == 0 {
+			badIndices = append(badIndices, i)
+		}
+	}
+	// Only when E has all e of its roots among the shares does E divide Q;
+	// otherwise Q/E is not a polynomial and the shares cannot be decoded.
+	if len(badIndices) != e {
+		return nil, nil, fmt.Errorf("inconsistent system: too many corrupted shares to recover")
+	}
+
+	// P(0) = Q(0)/E(0): dividing the constant terms is enough because
+	// Q(x) = E(x)*P(x) holds identically, so it holds at x = 0 too.
+	e0 := eCoeffs[0]
+	if e0.Sign() == 0 {
+		return nil, nil, fmt.Errorf("failed to recover polynomial: error locator vanishes at 0")
+	}
+	e0Inv := new(big.Int).ModInverse(e0, prime)
+	if e0Inv == nil {
+		return nil, nil, fmt.Errorf("failed to recover polynomial: error locator constant term is not invertible")
+	}
+
+	secret := new(big.Int).Mul(qCoeffs[0], e0Inv)
+	secret.Mod(secret, prime)
+
+	return secret, badIndices, nil
+}
+
+// solveLinearSystemMod solves the given augmented linear system (each row is
+// `unknowns` coefficients followed by the right-hand side) over Z/pZ via
+// Gauss-Jordan elimination. Rows beyond the first `unknowns` independent
+// ones must reduce to 0 = 0; if they don't, the system is inconsistent.
+func solveLinearSystemMod(rows [][]*big.Int, unknowns int, prime *big.Int) ([]*big.Int, error) {
+	n := len(rows)
+	matrix := make([][]*big.Int, n)
+	for i, row := range rows {
+		matrix[i] = append([]*big.Int{}, row...)
+	}
+
+	pivotRow := 0
+	pivotCols := make([]int, 0, unknowns)
+	for col := 0; col < unknowns && pivotRow < n; col++ {
+		sel := -1
+		for r := pivotRow; r < n; r++ {
+			if matrix[r][col].Sign() != 0 {
+				sel = r
+				break
+			}
+		}
+		if sel == -1 {
+			continue
+		}
+		matrix[pivotRow], matrix[sel] = matrix[sel], matrix[pivotRow]
+
+		inv := new(big.Int).ModInverse(matrix[pivotRow][col], prime)
+		if inv == nil {
+			return nil, fmt.Errorf("singular matrix: no inverse for pivot")
+		}
+		for c := col; c <= unknowns; c++ {
+			matrix[pivotRow][c] = new(big.Int).Mod(new(big.Int).Mul(matrix[pivotRow][c], inv), prime)
+		}
+
+		for r := 0; r < n; r++ {
+			if r == pivotRow {
+				continue
+			}
+			factor := matrix[r][col]
+			if factor.Sign() == 0 {
+				continue
+			}
+			for c := col; c <= unknowns; c++ {
+				term := new(big.Int).Mul(factor, matrix[pivotRow][c])
+				matrix[r][c] = new(big.Int).Mod(new(big.Int).Sub(matrix[r][c], term), prime)
+			}
+		}
+
+		pivotCols = append(pivotCols, col)
+		pivotRow++
+	}
+
+	if pivotRow < unknowns {
+		return nil, fmt.Errorf("underdetermined system: could not find %d independent equations", unknowns)
+	}
+
+	for r := pivotRow; r < n; r++ {
+		for c := 0; c <= unknowns; c++ {
+			if matrix[r][c].Sign() != 0 {
+				return nil, fmt.Errorf("inconsistent system: too many corrupted shares to recover")
+			}
+		}
+	}
+
+	solution := make([]*big.Int, unknowns)
+	for i, col := range pivotCols {
+		solution[col] = new(big.Int).Mod(matrix[i][unknowns], prime)
+	}
+	return solution, nil
+}
+
+// pointsToJSON renders shares back into the same nested format parseJSON
+// accepts, so generated shares can be handed straight back to this tool for
+// reconstruction. The modulus is included so reconstruction uses modular
+// mode, and commitments (if any) are carried alongside for Feldman VSS.
+// points must carry *big.Int coordinates, as produced by Create/CreateVerifiable.
+func pointsToJSON(points []Point, k int, modulus *big.Int, commitments []*big.Int) (string, error) {
+	out := map[string]interface{}{
+		"keys": map[string]interface{}{
+			"n": len(points),
+			"k": k,
+		},
+	}
+	for _, p := range points {
+		out[p.X.(*big.Int).String()] = map[string]interface{}{
+			"base":  "10",
+			"value": p.Y.(*big.Int).String(),
+		}
+	}
+	if modulus != nil {
+		out["prime"] = modulus.String()
+	}
+	if commitments != nil {
+		commitmentStrs := make([]string, len(commitments))
+		for i, c := range commitments {
+			commitmentStrs[i] = c.String()
+		}
+		out["commitments"] = commitmentStrs
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to render shares as JSON: %v", err)
+	}
+	return string(data), nil
 }
 
 func readJSONFile(filename string) ([]byte, error) {
@@ -211,11 +705,179 @@ func readJSONFile(filename string) ([]byte, error) {
 	return data, nil
 }
 
+// runCreate implements the "create" CLI subcommand: it dealer-splits a
+// secret into Shamir shares and prints them in the same JSON format
+// solveSecretSharing reads. Passing a generator and subgroup order in
+// addition to the base four arguments switches into Feldman VSS mode, which
+// also publishes commitments recipients can verify their share against.
+func runCreate(args []string) {
+	if len(args) != 4 && len(args) != 6 {
+		log.Println("usage: create <minimum> <shares> <prime> <secret> [generator subgroupOrder]")
+		os.Exit(1)
+	}
+
+	minimum, err := strconv.Atoi(args[0])
+	if err != nil {
+		os.Exit(1)
+	}
+
+	shares, err := strconv.Atoi(args[1])
+	if err != nil {
+		os.Exit(1)
+	}
+
+	prime, ok := new(big.Int).SetString(args[2], 10)
+	if !ok {
+		os.Exit(1)
+	}
+
+	secret, ok := new(big.Int).SetString(args[3], 10)
+	if !ok {
+		os.Exit(1)
+	}
+
+	if len(args) == 4 {
+		points, err := Create(minimum, shares, prime, secret)
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+
+		out, err := pointsToJSON(points, minimum, prime, nil)
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+
+		fmt.Println(out)
+		return
+	}
+
+	generator, ok := new(big.Int).SetString(args[4], 10)
+	if !ok {
+		os.Exit(1)
+	}
+
+	subgroupOrder, ok := new(big.Int).SetString(args[5], 10)
+	if !ok {
+		os.Exit(1)
+	}
+
+	points, commitments, err := CreateVerifiable(minimum, shares, prime, generator, subgroupOrder, secret)
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	out, err := pointsToJSON(points, minimum, subgroupOrder, commitments)
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Println(out)
+}
+
+// runSplit implements the "split" CLI subcommand: it shards an arbitrary
+// file into n share files (one per recipient) under outputDir, any k of
+// which are enough to recover the original file via "combine".
+func runSplit(args []string) {
+	if len(args) != 4 {
+		log.Println("usage: split <input-file> <k> <n> <output-dir>")
+		os.Exit(1)
+	}
+
+	secret, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	k, err := strconv.Atoi(args[1])
+	if err != nil {
+		os.Exit(1)
+	}
+
+	n, err := strconv.Atoi(args[2])
+	if err != nil {
+		os.Exit(1)
+	}
+
+	shares, err := sharing.SplitBytes(secret, k, n)
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	outputDir := args[3]
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	for i, share := range shares {
+		path := filepath.Join(outputDir, fmt.Sprintf("share-%d.bin", i+1))
+		if err := ioutil.WriteFile(path, share, 0o600); err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+	}
+}
+
+// runCombine implements the "combine" CLI subcommand: it recovers the
+// original file from k or more share files written by "split" and writes
+// it to stdout.
+func runCombine(args []string) {
+	if len(args) < 2 {
+		log.Println("usage: combine <k> <share-file>...")
+		os.Exit(1)
+	}
+
+	k, err := strconv.Atoi(args[0])
+	if err != nil {
+		os.Exit(1)
+	}
+
+	shareFiles := args[1:]
+	shares := make([][]byte, len(shareFiles))
+	for i, path := range shareFiles {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+		shares[i] = data
+	}
+
+	secret, err := sharing.CombineBytes(shares, k)
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(secret)
+}
+
 func main() {
 	if len(os.Args) < 2 {
 		os.Exit(1)
 	}
 
+	if os.Args[1] == "create" {
+		runCreate(os.Args[2:])
+		return
+	}
+
+	if os.Args[1] == "split" {
+		runSplit(os.Args[2:])
+		return
+	}
+
+	if os.Args[1] == "combine" {
+		runCombine(os.Args[2:])
+		return
+	}
+
 	filename := os.Args[1]
 
 	// Check if file exists
@@ -0,0 +1,350 @@
+package main
+
+import (
+	"crypto/elliptic"
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+// A small 256-bit-ish prime used by the dealer/reconstruction tests below;
+// it has no special structure requirements, unlike the Feldman VSS
+// parameters further down.
+var testPrime, _ = new(big.Int).SetString("208351617316091241234326746312124448251235562226470491514186331217050270460481", 10)
+
+func TestCreateAndLagrangeInterpolateMod(t *testing.T) {
+	secret := big.NewInt(424242)
+
+	points, err := Create(3, 6, testPrime, secret)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if len(points) != 6 {
+		t.Fatalf("expected 6 points, got %d", len(points))
+	}
+
+	got := LagrangeInterpolateMod(points, 3, testPrime)
+	if got == nil {
+		t.Fatalf("LagrangeInterpolateMod returned nil")
+	}
+	if got.Cmp(secret) != 0 {
+		t.Errorf("recovered secret = %s, want %s", got, secret)
+	}
+
+	// Any other subset of k points should reconstruct the same secret.
+	subset := []Point{points[1], points[3], points[5]}
+	got = LagrangeInterpolateMod(subset, 3, testPrime)
+	if got == nil || got.Cmp(secret) != 0 {
+		t.Errorf("recovered secret from alternate subset = %v, want %s", got, secret)
+	}
+}
+
+func TestCreateValidation(t *testing.T) {
+	secret := big.NewInt(1)
+	if _, err := Create(0, 3, testPrime, secret); err == nil {
+		t.Error("expected error for minimum < 1")
+	}
+	if _, err := Create(4, 3, testPrime, secret); err == nil {
+		t.Error("expected error for shares < minimum")
+	}
+	if _, err := Create(2, 3, testPrime, new(big.Int).Neg(big.NewInt(1))); err == nil {
+		t.Error("expected error for negative secret")
+	}
+	if _, err := Create(2, 3, testPrime, testPrime); err == nil {
+		t.Error("expected error for secret >= prime")
+	}
+}
+
+func TestLagrangeInterpolateModRejectsNonIntegerPoints(t *testing.T) {
+	points := []Point{
+		{X: big.NewRat(1, 1), Y: big.NewRat(2, 1)},
+		{X: big.NewRat(2, 1), Y: big.NewRat(3, 1)},
+	}
+	if got := LagrangeInterpolateMod(points, 2, testPrime); got != nil {
+		t.Errorf("expected nil for non-*big.Int points, got %v", got)
+	}
+}
+
+// Safe-prime Feldman VSS parameters: p = 2q+1 with both prime, and g a
+// quadratic residue mod p so it generates the order-q subgroup.
+var (
+	vssP, _ = new(big.Int).SetString("2000000000000000000000000000543", 10)
+	vssQ, _ = new(big.Int).SetString("1000000000000000000000000000271", 10)
+	vssG    = big.NewInt(25)
+)
+
+func TestCreateVerifiableAndVerifyShare(t *testing.T) {
+	secret := big.NewInt(777)
+
+	points, commitments, err := CreateVerifiable(3, 5, vssP, vssG, vssQ, secret)
+	if err != nil {
+		t.Fatalf("CreateVerifiable failed: %v", err)
+	}
+
+	for i, pt := range points {
+		if !VerifyShare(pt, commitments, vssP, vssG) {
+			t.Errorf("share %d failed to verify against honest commitments", i)
+		}
+	}
+
+	got := LagrangeInterpolateMod(points, 3, vssQ)
+	if got == nil || got.Cmp(secret) != 0 {
+		t.Errorf("recovered secret = %v, want %s", got, secret)
+	}
+}
+
+func TestVerifyShareDetectsCheatingDealer(t *testing.T) {
+	secret := big.NewInt(777)
+
+	points, commitments, err := CreateVerifiable(3, 5, vssP, vssG, vssQ, secret)
+	if err != nil {
+		t.Fatalf("CreateVerifiable failed: %v", err)
+	}
+
+	tampered := points[0]
+	tampered.Y = new(big.Int).Add(tampered.Y.(*big.Int), big.NewInt(1))
+	tampered.Y = new(big.Int).Mod(tampered.Y.(*big.Int), vssQ)
+
+	if VerifyShare(tampered, commitments, vssP, vssG) {
+		t.Error("VerifyShare accepted a tampered share")
+	}
+}
+
+func TestVerifyShareRejectsNonIntegerPoint(t *testing.T) {
+	commitments := []*big.Int{vssG}
+	point := Point{X: big.NewRat(1, 1), Y: big.NewRat(1, 1)}
+	if VerifyShare(point, commitments, vssP, vssG) {
+		t.Error("expected VerifyShare to reject a non-*big.Int point")
+	}
+}
+
+func TestVerifyShareRejectsNoCommitments(t *testing.T) {
+	point := Point{X: big.NewInt(1), Y: big.NewInt(1)}
+	if VerifyShare(point, nil, vssP, vssG) {
+		t.Error("expected VerifyShare to reject when there are no commitments")
+	}
+}
+
+func TestRobustReconstructNoErrors(t *testing.T) {
+	secret := big.NewInt(123456)
+
+	// n == k means e == 0, taking the direct LagrangeInterpolateMod path
+	// rather than the Berlekamp-Welch solver.
+	points, err := Create(3, 3, testPrime, secret)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	got, bad, err := RobustReconstruct(points, 3, testPrime)
+	if err != nil {
+		t.Fatalf("RobustReconstruct failed: %v", err)
+	}
+	if len(bad) != 0 {
+		t.Errorf("expected no bad indices, got %v", bad)
+	}
+	if got.Cmp(secret) != 0 {
+		t.Errorf("recovered secret = %s, want %s", got, secret)
+	}
+}
+
+func TestRobustReconstructCorrectsErrors(t *testing.T) {
+	secret := big.NewInt(123456)
+
+	points, err := Create(3, 7, testPrime, secret)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// e = (7-3)/2 = 2 errors correctable; corrupt two shares' y values.
+	corrupted := map[int]bool{1: true, 4: true}
+	tampered := make([]Point, len(points))
+	copy(tampered, points)
+	for i := range tampered {
+		if corrupted[i] {
+			y := tampered[i].Y.(*big.Int)
+			tampered[i].Y = new(big.Int).Mod(new(big.Int).Add(y, big.NewInt(999)), testPrime)
+		}
+	}
+
+	got, bad, err := RobustReconstruct(tampered, 3, testPrime)
+	if err != nil {
+		t.Fatalf("RobustReconstruct failed: %v", err)
+	}
+	if got.Cmp(secret) != 0 {
+		t.Errorf("recovered secret = %s, want %s", got, secret)
+	}
+	if len(bad) != len(corrupted) {
+		t.Fatalf("expected %d bad indices, got %v", len(corrupted), bad)
+	}
+	for _, idx := range bad {
+		if !corrupted[idx] {
+			t.Errorf("flagged index %d as bad, but it wasn't corrupted", idx)
+		}
+	}
+}
+
+func TestRobustReconstructTooManyErrors(t *testing.T) {
+	secret := big.NewInt(123456)
+
+	// n=5, k=2 can only correct e=1 error; corrupting 2 shares pushes the
+	// Berlekamp-Welch linear system past its unique-solution bound, which
+	// should surface as an inconsistent system rather than a wrong answer.
+	points, err := Create(2, 5, testPrime, secret)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	for _, i := range []int{0, 2} {
+		y := points[i].Y.(*big.Int)
+		points[i].Y = new(big.Int).Mod(new(big.Int).Add(y, big.NewInt(999)), testPrime)
+	}
+
+	_, _, err = RobustReconstruct(points, 2, testPrime)
+	if err == nil {
+		t.Error("expected an error when more shares are corrupted than correctable")
+	}
+}
+
+func TestRobustReconstructInsufficientPoints(t *testing.T) {
+	points := []Point{
+		{X: big.NewInt(1), Y: big.NewInt(1)},
+		{X: big.NewInt(2), Y: big.NewInt(2)},
+	}
+	if _, _, err := RobustReconstruct(points, 3, testPrime); err == nil {
+		t.Error("expected an error when fewer points than k are supplied")
+	}
+}
+
+func TestRobustReconstructRejectsInvalidK(t *testing.T) {
+	points := []Point{
+		{X: big.NewInt(1), Y: big.NewInt(1)},
+	}
+	if _, _, err := RobustReconstruct(points, 0, testPrime); err == nil {
+		t.Error("expected an error for k < 1")
+	}
+}
+
+// buildSharesJSON renders a 2-of-2 JSON payload for the degree-1 polynomial
+// f(x) = secret + a1*x, optionally reduced mod m, in the shape parseJSON
+// expects. fieldObj, when non-nil, is marshaled as the "field" key;
+// legacyPrime, when non-empty, is marshaled as the old bare "prime" string.
+// At most one of the two should be set.
+func buildSharesJSON(secret, a1, m *big.Int, fieldObj map[string]interface{}, legacyPrime string) string {
+	eval := func(x int64) *big.Int {
+		y := new(big.Int).Add(secret, new(big.Int).Mul(a1, big.NewInt(x)))
+		if m != nil {
+			y.Mod(y, m)
+		}
+		return y
+	}
+
+	out := map[string]interface{}{
+		"keys": map[string]interface{}{"k": 2, "n": 2},
+		"1":    map[string]interface{}{"base": "10", "value": eval(1).String()},
+		"2":    map[string]interface{}{"base": "10", "value": eval(2).String()},
+	}
+	if fieldObj != nil {
+		out["field"] = fieldObj
+	}
+	if legacyPrime != "" {
+		out["prime"] = legacyPrime
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func TestSolveSecretSharingPlainPayload(t *testing.T) {
+	secret := big.NewInt(555)
+	a1 := big.NewInt(17)
+
+	payload := buildSharesJSON(secret, a1, nil, nil, "")
+
+	got, err := solveSecretSharing(payload)
+	if err != nil {
+		t.Fatalf("solveSecretSharing failed: %v", err)
+	}
+	if got.Cmp(secret) != 0 {
+		t.Errorf("got %s, want %s", got, secret)
+	}
+}
+
+func TestSolveSecretSharingLegacyPrimeString(t *testing.T) {
+	secret := big.NewInt(555)
+	a1 := big.NewInt(17)
+
+	payload := buildSharesJSON(secret, a1, testPrime, nil, testPrime.String())
+
+	got, err := solveSecretSharing(payload)
+	if err != nil {
+		t.Fatalf("solveSecretSharing failed: %v", err)
+	}
+	if got.Cmp(secret) != 0 {
+		t.Errorf("got %s, want %s", got, secret)
+	}
+}
+
+func TestSolveSecretSharingFieldPrime(t *testing.T) {
+	secret := big.NewInt(555)
+	a1 := big.NewInt(17)
+
+	fieldObj := map[string]interface{}{"type": "prime", "modulus": testPrime.String()}
+	payload := buildSharesJSON(secret, a1, testPrime, fieldObj, "")
+
+	got, err := solveSecretSharing(payload)
+	if err != nil {
+		t.Fatalf("solveSecretSharing failed: %v", err)
+	}
+	if got.Cmp(secret) != 0 {
+		t.Errorf("got %s, want %s", got, secret)
+	}
+}
+
+func TestSolveSecretSharingFieldCurve(t *testing.T) {
+	for _, name := range []string{"p256", "ed25519"} {
+		t.Run(name, func(t *testing.T) {
+			order, err := CurveField(name)
+			if err != nil {
+				t.Fatalf("CurveField(%q) failed: %v", name, err)
+			}
+			modulus := order.(PrimeField).P
+
+			secret := big.NewInt(555)
+			a1 := big.NewInt(17)
+
+			fieldObj := map[string]interface{}{"type": "curve", "name": name}
+			payload := buildSharesJSON(secret, a1, modulus, fieldObj, "")
+
+			got, err := solveSecretSharing(payload)
+			if err != nil {
+				t.Fatalf("solveSecretSharing failed: %v", err)
+			}
+			if got.Cmp(secret) != 0 {
+				t.Errorf("got %s, want %s", got, secret)
+			}
+		})
+	}
+}
+
+func TestParseFieldUnsupportedCurve(t *testing.T) {
+	_, err := parseField(map[string]interface{}{
+		"field": map[string]interface{}{"type": "curve", "name": "not-a-curve"},
+	})
+	if err == nil {
+		t.Error("expected an error for an unsupported curve name")
+	}
+}
+
+func TestCurveFieldP256MatchesStdlibOrder(t *testing.T) {
+	field, err := CurveField("p256")
+	if err != nil {
+		t.Fatalf("CurveField(\"p256\") failed: %v", err)
+	}
+	got := field.(PrimeField).P
+	want := elliptic.P256().Params().N
+	if got.Cmp(want) != 0 {
+		t.Errorf("p256 order = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,241 @@
+// Package sharing shards arbitrary byte payloads using Shamir's Secret
+// Sharing, splitting the input into fixed-size field elements and running
+// the scheme independently over each one. It exists alongside the
+// big.Int-oriented reconstruction in the root package so non-numeric
+// secrets (files, keys, arbitrary blobs) can be split and combined too.
+package sharing
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+// ChunkSize is the default number of secret bytes packed into a single
+// field element before sharing; 31 bytes keeps every chunk strictly below
+// the 256-bit prime modulus.
+const ChunkSize = 31
+
+// valueSize is the fixed width, in bytes, used to serialize each chunk's
+// share value, regardless of its actual magnitude.
+const valueSize = 32
+
+// maxShares is the largest number of recipients supported, since a share's
+// x-coordinate is packed into a single byte.
+const maxShares = 255
+
+// prime is the fixed 256-bit modulus every chunk is shared over (the
+// secp256k1 field prime; chosen simply because it's a well-known,
+// widely-reviewed 256-bit prime).
+var prime, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+
+type point struct {
+	x *big.Int
+	y *big.Int
+}
+
+// SplitBytes shards an arbitrary secret into n shares, any k of which
+// reconstruct it. The secret is length-framed and split into ChunkSize-byte
+// field elements, each shared independently over the fixed 256-bit prime;
+// every returned share concatenates its x-coordinate with one y value per
+// chunk, in chunk order.
+func SplitBytes(secret []byte, k, n int) ([][]byte, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("invalid threshold: k=%d must be at least 1", k)
+	}
+	if n < k {
+		return nil, fmt.Errorf("shares (%d) must be >= threshold (%d)", n, k)
+	}
+	if n > maxShares {
+		return nil, fmt.Errorf("shares (%d) exceeds maximum of %d", n, maxShares)
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret must not be empty")
+	}
+
+	framed := make([]byte, 4+len(secret))
+	binary.BigEndian.PutUint32(framed, uint32(len(secret)))
+	copy(framed[4:], secret)
+
+	numChunks := (len(framed) + ChunkSize - 1) / ChunkSize
+	chunkPoints := make([][]point, numChunks)
+	for c := 0; c < numChunks; c++ {
+		start := c * ChunkSize
+		end := start + ChunkSize
+		if end > len(framed) {
+			end = len(framed)
+		}
+
+		buf := make([]byte, ChunkSize)
+		copy(buf, framed[start:end])
+
+		points, err := splitChunk(new(big.Int).SetBytes(buf), k, n)
+		if err != nil {
+			return nil, err
+		}
+		chunkPoints[c] = points
+	}
+
+	shares := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		share := make([]byte, 1, 1+numChunks*valueSize)
+		share[0] = byte(i + 1)
+		for c := 0; c < numChunks; c++ {
+			share = append(share, padTo(chunkPoints[c][i].y.Bytes(), valueSize)...)
+		}
+		shares[i] = share
+	}
+
+	return shares, nil
+}
+
+// CombineBytes reconstructs the original secret from k or more shares
+// produced by SplitBytes.
+func CombineBytes(shares [][]byte, k int) ([]byte, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("invalid threshold: k=%d must be at least 1", k)
+	}
+	if len(shares) < k {
+		return nil, fmt.Errorf("insufficient shares: need %d, got %d", k, len(shares))
+	}
+
+	shareLen := len(shares[0])
+	if shareLen < 1 || (shareLen-1)%valueSize != 0 {
+		return nil, fmt.Errorf("malformed share: unexpected length %d", shareLen)
+	}
+	numChunks := (shareLen - 1) / valueSize
+
+	for _, s := range shares {
+		if len(s) != shareLen {
+			return nil, fmt.Errorf("share length mismatch: expected %d, got %d", shareLen, len(s))
+		}
+	}
+
+	framed := make([]byte, 0, numChunks*ChunkSize)
+	for c := 0; c < numChunks; c++ {
+		points := make([]point, len(shares))
+		for i, s := range shares {
+			offset := 1 + c*valueSize
+			points[i] = point{
+				x: big.NewInt(int64(s[0])),
+				y: new(big.Int).SetBytes(s[offset : offset+valueSize]),
+			}
+		}
+
+		chunkSecret, err := interpolateChunk(points, k)
+		if err != nil {
+			return nil, err
+		}
+		framed = append(framed, padTo(chunkSecret.Bytes(), ChunkSize)...)
+	}
+
+	if len(framed) < 4 {
+		return nil, fmt.Errorf("recovered data too short to contain length header")
+	}
+	length := binary.BigEndian.Uint32(framed[:4])
+	if int(length) > len(framed)-4 {
+		return nil, fmt.Errorf("recovered length header out of range")
+	}
+
+	return framed[4 : 4+length], nil
+}
+
+// splitChunk dealer-splits a single field element the same way the root
+// package's Create does, just scoped to this package's fixed prime.
+func splitChunk(secret *big.Int, k, n int) ([]point, error) {
+	coeffs := make([]*big.Int, k)
+	coeffs[0] = secret
+	for i := 1; i < k; i++ {
+		c, err := rand.Int(rand.Reader, prime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate coefficient: %v", err)
+		}
+		coeffs[i] = c
+	}
+
+	points := make([]point, n)
+	for x := 1; x <= n; x++ {
+		xBig := big.NewInt(int64(x))
+		points[x-1] = point{x: xBig, y: evalPolynomial(coeffs, xBig)}
+	}
+	return points, nil
+}
+
+func evalPolynomial(coeffs []*big.Int, x *big.Int) *big.Int {
+	result := big.NewInt(0)
+	xPow := big.NewInt(1)
+	term := new(big.Int)
+	for _, c := range coeffs {
+		term.Mul(c, xPow)
+		result.Add(result, term)
+		result.Mod(result, prime)
+		xPow.Mul(xPow, x)
+		xPow.Mod(xPow, prime)
+	}
+	return result
+}
+
+// interpolateChunk recovers a single field element via Lagrange
+// interpolation in Z/pZ, mirroring LagrangeInterpolateMod in the root
+// package but scoped to this package's fixed prime.
+func interpolateChunk(points []point, k int) (*big.Int, error) {
+	if len(points) < k {
+		return nil, fmt.Errorf("insufficient shares: need %d, got %d", k, len(points))
+	}
+
+	selected := points[:k]
+	result := big.NewInt(0)
+
+	for i := 0; i < len(selected); i++ {
+		numerator := big.NewInt(1)
+		denominator := big.NewInt(1)
+
+		for j := 0; j < len(selected); j++ {
+			if i == j {
+				continue
+			}
+
+			xi := selected[i].x
+			xj := selected[j].x
+
+			numerator.Mul(numerator, new(big.Int).Neg(xj))
+			numerator.Mod(numerator, prime)
+
+			diff := new(big.Int).Sub(xi, xj)
+			diff.Mod(diff, prime)
+			if diff.Sign() == 0 {
+				return nil, fmt.Errorf("duplicate x coordinate in shares")
+			}
+			denominator.Mul(denominator, diff)
+			denominator.Mod(denominator, prime)
+		}
+
+		denomInv := new(big.Int).ModInverse(denominator, prime)
+		if denomInv == nil {
+			return nil, fmt.Errorf("share x coordinate not invertible mod prime")
+		}
+
+		basisValue := new(big.Int).Mul(numerator, denomInv)
+		basisValue.Mod(basisValue, prime)
+
+		term := new(big.Int).Mul(selected[i].y, basisValue)
+		term.Mod(term, prime)
+
+		result.Add(result, term)
+		result.Mod(result, prime)
+	}
+
+	return result, nil
+}
+
+// padTo left-pads (or truncates to the trailing bytes of) b so it is
+// exactly size bytes long, for fixed-width serialization.
+func padTo(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b[len(b)-size:]
+	}
+	out := make([]byte, size)
+	copy(out[size-len(b):], b)
+	return out
+}
@@ -0,0 +1,138 @@
+package sharing
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSplitCombineRoundTrip(t *testing.T) {
+	secret := []byte("the quick brown fox jumps over the lazy dog")
+
+	shares, err := SplitBytes(secret, 3, 5)
+	if err != nil {
+		t.Fatalf("SplitBytes failed: %v", err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("expected 5 shares, got %d", len(shares))
+	}
+
+	got, err := CombineBytes(shares[:3], 3)
+	if err != nil {
+		t.Fatalf("CombineBytes failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Errorf("recovered secret = %q, want %q", got, secret)
+	}
+
+	// Any other k-subset should recover the same secret.
+	subset := [][]byte{shares[1], shares[2], shares[4]}
+	got, err = CombineBytes(subset, 3)
+	if err != nil {
+		t.Fatalf("CombineBytes on alternate subset failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Errorf("recovered secret from alternate subset = %q, want %q", got, secret)
+	}
+}
+
+func TestSplitCombineMultiChunk(t *testing.T) {
+	secret := bytes.Repeat([]byte{0xAB}, ChunkSize*3+5)
+
+	shares, err := SplitBytes(secret, 2, 4)
+	if err != nil {
+		t.Fatalf("SplitBytes failed: %v", err)
+	}
+
+	got, err := CombineBytes(shares[:2], 2)
+	if err != nil {
+		t.Fatalf("CombineBytes failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Errorf("recovered secret mismatch for multi-chunk payload")
+	}
+}
+
+func TestSplitCombineBoundaryChunkSizes(t *testing.T) {
+	sizes := []int{1, ChunkSize - 1, ChunkSize, ChunkSize + 1, ChunkSize * 2}
+	for _, size := range sizes {
+		secret := bytes.Repeat([]byte{0x7F}, size)
+
+		shares, err := SplitBytes(secret, 2, 3)
+		if err != nil {
+			t.Fatalf("SplitBytes failed for size %d: %v", size, err)
+		}
+
+		got, err := CombineBytes(shares[:2], 2)
+		if err != nil {
+			t.Fatalf("CombineBytes failed for size %d: %v", size, err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Errorf("size %d: recovered secret mismatch", size)
+		}
+	}
+}
+
+func TestSplitCombineLeadingZeroBytes(t *testing.T) {
+	secret := make([]byte, ChunkSize+10)
+	secret[0] = 0
+	secret[1] = 0
+	secret[2] = 0x01
+	for i := 3; i < len(secret); i++ {
+		secret[i] = byte(i)
+	}
+
+	shares, err := SplitBytes(secret, 3, 5)
+	if err != nil {
+		t.Fatalf("SplitBytes failed: %v", err)
+	}
+
+	got, err := CombineBytes(shares[:3], 3)
+	if err != nil {
+		t.Fatalf("CombineBytes failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Errorf("recovered secret with leading zero bytes = %x, want %x", got, secret)
+	}
+}
+
+func TestSplitBytesValidation(t *testing.T) {
+	if _, err := SplitBytes([]byte("x"), 0, 3); err == nil {
+		t.Error("expected error for k < 1")
+	}
+	if _, err := SplitBytes([]byte("x"), 4, 3); err == nil {
+		t.Error("expected error for n < k")
+	}
+	if _, err := SplitBytes([]byte{}, 1, 3); err == nil {
+		t.Error("expected error for empty secret")
+	}
+	if _, err := SplitBytes([]byte("x"), 1, maxShares+1); err == nil {
+		t.Error("expected error for n exceeding maxShares")
+	}
+}
+
+func TestCombineBytesValidation(t *testing.T) {
+	secret := []byte("hello")
+	shares, err := SplitBytes(secret, 3, 5)
+	if err != nil {
+		t.Fatalf("SplitBytes failed: %v", err)
+	}
+
+	if _, err := CombineBytes(shares[:2], 3); err == nil {
+		t.Error("expected error for fewer shares than k")
+	}
+	if _, err := CombineBytes(shares[:3], 0); err == nil {
+		t.Error("expected error for k < 1")
+	}
+
+	malformed := append([]byte{}, shares[0]...)
+	malformed = malformed[:len(malformed)-1]
+	if _, err := CombineBytes([][]byte{malformed, shares[1], shares[2]}, 3); err == nil {
+		t.Error("expected error for malformed share length")
+	}
+
+	mismatched := append([][]byte{}, shares[:3]...)
+	mismatched[1] = append(append([]byte{}, mismatched[1]...), 0)
+	if _, err := CombineBytes(mismatched, 3); err == nil {
+		t.Error("expected error for share length mismatch")
+	}
+}